@@ -0,0 +1,241 @@
+package logger
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DropPolicy controls how an async Logger behaves when its internal
+// buffer is full.
+type DropPolicy int
+
+// The drop policies supported by AsyncOptions.
+const (
+	// Block makes the caller wait until the buffer has room.
+	Block DropPolicy = iota
+	// DropOldest discards the oldest buffered entry to make room.
+	DropOldest
+	// DropNewest discards the entry that was about to be buffered.
+	DropNewest
+)
+
+// AsyncOptions configures a Logger's async mode, enabled via
+// Logger.EnableAsync.
+type AsyncOptions struct {
+	// BufferSize is the capacity of the channel entries are queued on
+	// before being delivered to Sinks by the background goroutine.
+	// Defaults to 1024 when <= 0.
+	BufferSize int
+
+	// FlushInterval is how often the background goroutine wakes up when
+	// idle. Entries are delivered to Sinks as soon as they're dequeued, so
+	// the wake-up itself does no work and doesn't change how long Flush
+	// blocks. Defaults to 30 seconds when <= 0.
+	FlushInterval time.Duration
+
+	// DropPolicy controls what happens when the buffer is full.
+	DropPolicy DropPolicy
+}
+
+// asyncState holds everything a Logger needs to run in async mode: the
+// entry queue the background goroutine drains, and the channel Flush uses
+// to synchronize with it.
+type asyncState struct {
+	queue      chan Entry
+	dropPolicy DropPolicy
+	dropped    int64 // atomic
+
+	flushReq chan chan struct{}
+	stop     chan struct{}
+}
+
+// EnableAsync switches l into async mode: subsequent log calls build
+// their Entry and hand it off to a dedicated goroutine, which delivers it
+// to l's Sinks, rather than blocking the caller on however long that
+// delivery takes (file I/O, a syslog round trip, a network write).
+//
+// EnableAsync is a no-op if l is already in async mode.
+func (l *Logger) EnableAsync(opts AsyncOptions) {
+	l.mu.Lock()
+	if l.async != nil {
+		l.mu.Unlock()
+		return
+	}
+
+	bufSize := opts.BufferSize
+	if bufSize <= 0 {
+		bufSize = 1024
+	}
+	interval := opts.FlushInterval
+	if interval <= 0 {
+		interval = 30 * time.Second
+	}
+
+	a := &asyncState{
+		queue:      make(chan Entry, bufSize),
+		dropPolicy: opts.DropPolicy,
+		flushReq:   make(chan chan struct{}),
+		stop:       make(chan struct{}),
+	}
+	l.async = a
+	l.mu.Unlock()
+
+	registerAsync(l)
+	go l.runAsync(interval)
+}
+
+// getAsync returns l's asyncState, or nil if l isn't running in async
+// mode. It synchronizes with EnableAsync via l.mu so concurrent log calls
+// never read l.async while it's being assigned.
+func (l *Logger) getAsync() *asyncState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.async
+}
+
+// runAsync is the background goroutine started by EnableAsync. It's the
+// only goroutine that calls l.writeToSinks for an async Logger, so Sinks
+// never see concurrent writes from the same Logger.
+func (l *Logger) runAsync(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	a := l.async
+	for {
+		select {
+		case e := <-a.queue:
+			l.writeToSinks(e)
+		case <-ticker.C:
+			// Idle wake-up; entries are delivered as they arrive, so
+			// there's nothing to do here.
+		case done := <-a.flushReq:
+			a.drainQueue(l)
+			close(done)
+		case <-a.stop:
+			return
+		}
+	}
+}
+
+// drainQueue delivers every entry currently buffered in the queue without
+// blocking, so Flush can be sure everything queued up to its call has
+// reached l's Sinks.
+func (a *asyncState) drainQueue(l *Logger) {
+	for {
+		select {
+		case e := <-a.queue:
+			l.writeToSinks(e)
+		default:
+			return
+		}
+	}
+}
+
+// enqueue hands entry to the background goroutine, applying
+// a.dropPolicy if the queue is full.
+func (a *asyncState) enqueue(entry Entry) {
+	select {
+	case a.queue <- entry:
+		return
+	default:
+	}
+
+	switch a.dropPolicy {
+	case Block:
+		a.queue <- entry
+	case DropNewest:
+		atomic.AddInt64(&a.dropped, 1)
+	case DropOldest:
+		select {
+		case <-a.queue:
+		default:
+		}
+		select {
+		case a.queue <- entry:
+		default:
+			atomic.AddInt64(&a.dropped, 1)
+		}
+	}
+}
+
+// Flush blocks until every entry queued on l so far has reached its
+// Sinks. It's a no-op for Loggers not running in async mode.
+func (l *Logger) Flush() {
+	a := l.getAsync()
+	if a == nil {
+		return
+	}
+
+	done := make(chan struct{})
+	a.flushReq <- done
+	<-done
+}
+
+// Dropped returns the number of entries l has discarded because its
+// async buffer was full and DropPolicy wasn't Block. It's always zero for
+// Loggers not running in async mode.
+func (l *Logger) Dropped() int64 {
+	a := l.getAsync()
+	if a == nil {
+		return 0
+	}
+	return atomic.LoadInt64(&a.dropped)
+}
+
+var (
+	asyncLoggersMu sync.Mutex
+	asyncLoggers   []*Logger
+)
+
+// registerAsync records l so DrainAll can flush it.
+func registerAsync(l *Logger) {
+	asyncLoggersMu.Lock()
+	asyncLoggers = append(asyncLoggers, l)
+	asyncLoggersMu.Unlock()
+}
+
+// unregisterAsync removes l from the set DrainAll flushes. It's a no-op
+// if l was never registered, so it's safe to call on every Logger passed
+// to Close, async or not.
+func unregisterAsync(l *Logger) {
+	asyncLoggersMu.Lock()
+	defer asyncLoggersMu.Unlock()
+	for i, cur := range asyncLoggers {
+		if cur == l {
+			asyncLoggers = append(asyncLoggers[:i], asyncLoggers[i+1:]...)
+			return
+		}
+	}
+}
+
+// stopAsync terminates l's background goroutine, if it's running one.
+// It's a no-op for Loggers not running in async mode.
+func (l *Logger) stopAsync() {
+	a := l.getAsync()
+	if a == nil {
+		return
+	}
+	close(a.stop)
+}
+
+// DrainAll flushes every Logger currently running in async mode. It's
+// called automatically before Fatal/Panic exit or panic the process.
+// Go has no general hook for a normal process exit, so programs using
+// async Loggers should also defer logger.DrainAll() (or logger.Flush())
+// in main to avoid losing buffered messages on a clean shutdown.
+func DrainAll() {
+	asyncLoggersMu.Lock()
+	loggers := make([]*Logger, len(asyncLoggers))
+	copy(loggers, asyncLoggers)
+	asyncLoggersMu.Unlock()
+
+	for _, l := range loggers {
+		l.Flush()
+	}
+}
+
+// Flush flushes the standard logger's buffered async entries, if any.
+func Flush() {
+	std().Flush()
+}
@@ -0,0 +1,138 @@
+package logger
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestEnableAsyncConcurrentWithLogging guards against l.async being read
+// and written without synchronization between print and EnableAsync: one
+// goroutine logs while another switches l into async mode. Run with
+// -race to catch a regression.
+func TestEnableAsyncConcurrentWithLogging(t *testing.T) {
+	sink := &captureSink{}
+	l := &Logger{sinks: []Sink{sink}}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			l.Info("hello")
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		l.EnableAsync(AsyncOptions{FlushInterval: 10 * time.Millisecond})
+	}()
+
+	wg.Wait()
+	l.Flush()
+}
+
+// TestCloseStopsAsyncGoroutine guards against Close leaving a Logger's
+// background goroutine running and registered with DrainAll after the
+// Logger itself is gone.
+func TestCloseStopsAsyncGoroutine(t *testing.T) {
+	sink := &captureSink{}
+	l := &Logger{sinks: []Sink{sink}}
+	l.EnableAsync(AsyncOptions{FlushInterval: 10 * time.Millisecond})
+
+	mu.Lock()
+	if err := loggers.register(t.TempDir(), "async-close-test", l); err != nil {
+		mu.Unlock()
+		t.Fatalf("register: %v", err)
+	}
+	mu.Unlock()
+
+	if err := Close("async-close-test"); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	select {
+	case <-l.async.stop:
+	default:
+		t.Error("a.stop not closed after Close; runAsync goroutine is still running")
+	}
+
+	asyncLoggersMu.Lock()
+	for _, cur := range asyncLoggers {
+		if cur == l {
+			asyncLoggersMu.Unlock()
+			t.Fatal("Logger still present in asyncLoggers after Close")
+		}
+	}
+	asyncLoggersMu.Unlock()
+}
+
+// TestEnqueueDropNewest guards against DropNewest discarding anything
+// but the entry that was about to be enqueued, once the buffer is full.
+func TestEnqueueDropNewest(t *testing.T) {
+	a := &asyncState{queue: make(chan Entry, 2), dropPolicy: DropNewest}
+
+	a.enqueue(Entry{Message: "1"})
+	a.enqueue(Entry{Message: "2"})
+	a.enqueue(Entry{Message: "3"}) // buffer full; should be the one dropped
+
+	if got := a.dropped; got != 1 {
+		t.Errorf("dropped = %d, want 1", got)
+	}
+
+	close(a.queue)
+	var got []string
+	for e := range a.queue {
+		got = append(got, e.Message)
+	}
+	if len(got) != 2 || got[0] != "1" || got[1] != "2" {
+		t.Errorf("queue contents = %v, want [1 2]", got)
+	}
+}
+
+// TestEnqueueDropOldest guards against DropOldest discarding anything
+// but the longest-queued entry, once the buffer is full.
+func TestEnqueueDropOldest(t *testing.T) {
+	a := &asyncState{queue: make(chan Entry, 2), dropPolicy: DropOldest}
+
+	a.enqueue(Entry{Message: "1"})
+	a.enqueue(Entry{Message: "2"})
+	a.enqueue(Entry{Message: "3"}) // buffer full; "1" should be evicted
+
+	if got := a.dropped; got != 0 {
+		t.Errorf("dropped = %d, want 0 (DropOldest evicts, it doesn't count as dropped)", got)
+	}
+
+	close(a.queue)
+	var got []string
+	for e := range a.queue {
+		got = append(got, e.Message)
+	}
+	if len(got) != 2 || got[0] != "2" || got[1] != "3" {
+		t.Errorf("queue contents = %v, want [2 3]", got)
+	}
+}
+
+// TestEnqueueBlock guards against the Block policy (the zero value)
+// dropping an entry instead of waiting for room in the queue.
+func TestEnqueueBlock(t *testing.T) {
+	a := &asyncState{queue: make(chan Entry, 1)}
+
+	a.enqueue(Entry{Message: "1"})
+
+	done := make(chan struct{})
+	go func() {
+		a.enqueue(Entry{Message: "2"})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("enqueue returned before the queue had room; Block policy did not block")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	<-a.queue // make room
+	<-done
+}
@@ -5,34 +5,40 @@ consistent default log file formatting.
 
 Due to its nature as a wrapper of the standart Go log package, it's stuctured
 very similarly, complete with a standard logger that is accessible through
-the package-level functions, which writes to std.log, as well as a Logger type
-that can be used to create more loggers that point to different files and can
-be configured differently, so as to structure the logs better.
+the package-level functions, which writes to std.INFO.log, std.WARNING.log,
+std.ERROR.log and std.FATAL.log, as well as a Logger type that can be used
+to create more loggers that point to different files and can be configured
+differently, so as to structure the logs better.
 
 Package logger produces log folders in a "log" directory located in the running
 user's home directory. Inside the "log" directory exist folders describing the
 program names that use logger (see func Init()). In each program directory are
 produced timestamped folders for each run of the specified program, containing
-a file for each logger that is spawned and used during that run. This is a tree
-visualization of an example log directory structure:
+one file per severity (INFO, WARNING, ERROR, FATAL) for each logger that is
+spawned and used during that run. This is a tree visualization of an example
+log directory structure, for a run that created one extra Logger, "err", on
+top of the standard one:
 
 	homedir
 	└── log
-			├── prog1
-			│		├── std.log
-			│		└── err.log
-			├── prog2
-			│		└── std.log
-			└── prog3
-					├── std.log
-					├── stats.log
-					├── urgent.log
-					└── err.log
+			└── prog1
+					└── 2006-01-02_15-04-05
+							├── std.INFO.log
+							├── std.WARNING.log
+							├── std.ERROR.log
+							├── std.FATAL.log
+							├── err.INFO.log
+							├── err.WARNING.log
+							├── err.ERROR.log
+							└── err.FATAL.log
 
 
-Package logger keeps a set of files that it operates on so as to avoid runtime
-errors and instead return an error when a duplicate Logger is attempted. TODO:
-Link detection.
+Package logger keeps a registry of every Logger it creates, keyed by the
+canonical, symlink-resolved path it ultimately writes to, so as to avoid
+runtime errors and instead return an error when a duplicate Logger is
+attempted, even one reached through a symlinked or ".."-normalized alias of
+an existing logger's path. Loggers lists every currently registered name,
+and Close shuts one down and frees its name for reuse.
 
 Disabling logging for a session is as easy as not initializing the logger with
 the logger.Init() function. Any log messages can remain in the source code, and
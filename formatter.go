@@ -0,0 +1,160 @@
+package logger
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Entry carries everything a Formatter needs to render a single log
+// message: its timestamp, severity, indentation, call site, the message
+// itself, and any structured fields attached via Logger.WithFields.
+type Entry struct {
+	Time    time.Time
+	Level   Level
+	Tabs    int
+	File    string
+	Line    int
+	Message string
+	Fields  map[string]interface{}
+}
+
+// Formatter turns an Entry into the bytes written to a log file. The
+// returned slice should end in a newline.
+type Formatter interface {
+	Format(entry Entry) []byte
+}
+
+// defaultFormatter is the Formatter assigned to Loggers created by New,
+// unless SetDefaultFormatter has been called beforehand.
+var defaultFormatter Formatter = TextFormatter{}
+
+// SetDefaultFormatter changes the Formatter assigned to Loggers created
+// after this call. It does not affect Loggers that already exist.
+func SetDefaultFormatter(f Formatter) {
+	defaultFormatter = f
+}
+
+// SetFormatter changes the Formatter used by the standard logger.
+func SetFormatter(f Formatter) {
+	std().SetFormatter(f)
+}
+
+// TextFormatter reproduces logger's original output: a timestamp and
+// short file:line prefix, the tab indent, and the message.
+type TextFormatter struct{}
+
+// Format implements Formatter.
+func (TextFormatter) Format(e Entry) []byte {
+	var buf bytes.Buffer
+
+	buf.WriteString(e.Time.Format("2006/01/02 15:04:05"))
+	buf.WriteByte(' ')
+	buf.WriteString(filepath.Base(e.File))
+	buf.WriteByte(':')
+	buf.WriteString(strconv.Itoa(e.Line))
+	buf.WriteString(": ")
+	buf.WriteString(strings.Repeat("\t", e.Tabs))
+	buf.WriteString(e.Message)
+
+	if len(e.Fields) > 0 {
+		buf.WriteByte(' ')
+		writeFields(&buf, e.Fields)
+	}
+	if !strings.HasSuffix(buf.String(), "\n") {
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
+
+// JSONFormatter renders each Entry as a single JSON object per line,
+// suitable for ingestion by log-shipping tools.
+type JSONFormatter struct{}
+
+// Format implements Formatter.
+func (JSONFormatter) Format(e Entry) []byte {
+	obj := make(map[string]interface{}, len(e.Fields)+4)
+	for k, v := range e.Fields {
+		obj[k] = v
+	}
+	obj["time"] = e.Time.Format(time.RFC3339Nano)
+	obj["level"] = e.Level.String()
+	obj["source"] = fmt.Sprintf("%s:%d", filepath.Base(e.File), e.Line)
+	obj["message"] = strings.TrimRight(e.Message, "\n")
+
+	data, err := json.Marshal(obj)
+	if err != nil {
+		data = []byte(fmt.Sprintf(`{"level":"ERROR","message":"logger: failed to marshal entry: %s"}`, err))
+	}
+	return append(data, '\n')
+}
+
+// PatternFormatter renders entries using a format string made up of the
+// following tokens, with any other character copied verbatim:
+//
+//	%D  date, e.g. 2006-01-02
+//	%T  time, e.g. 15:04:05
+//	%L  level, e.g. WARNING
+//	%S  source, e.g. logger.go:42
+//	%M  message
+//	%t  tab indent
+//
+// Entry.Fields are not rendered.
+type PatternFormatter struct {
+	Pattern string
+}
+
+// Format implements Formatter.
+func (p PatternFormatter) Format(e Entry) []byte {
+	var buf bytes.Buffer
+
+	tokens := strings.Split(p.Pattern, "%")
+	buf.WriteString(tokens[0])
+	for _, tok := range tokens[1:] {
+		if tok == "" {
+			buf.WriteByte('%')
+			continue
+		}
+
+		switch tok[0] {
+		case 'D':
+			buf.WriteString(e.Time.Format("2006-01-02"))
+		case 'T':
+			buf.WriteString(e.Time.Format("15:04:05"))
+		case 'L':
+			buf.WriteString(e.Level.String())
+		case 'S':
+			buf.WriteString(fmt.Sprintf("%s:%d", filepath.Base(e.File), e.Line))
+		case 'M':
+			buf.WriteString(strings.TrimRight(e.Message, "\n"))
+		case 't':
+			buf.WriteString(strings.Repeat("\t", e.Tabs))
+		default:
+			buf.WriteByte('%')
+			buf.WriteByte(tok[0])
+		}
+		buf.WriteString(tok[1:])
+	}
+
+	buf.WriteByte('\n')
+	return buf.Bytes()
+}
+
+// writeFields appends a space-separated key=value rendering of fields to
+// buf, used by TextFormatter when a Logger carries fields set via
+// WithFields. Field order follows Go's map iteration and isn't stable
+// across calls.
+func writeFields(buf *bytes.Buffer, fields map[string]interface{}) {
+	first := true
+	for k, v := range fields {
+		if !first {
+			buf.WriteByte(' ')
+		}
+		first = false
+		fmt.Fprintf(buf, "%s=%v", k, v)
+	}
+}
@@ -0,0 +1,97 @@
+package logger
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func testEntry() Entry {
+	return Entry{
+		Time:    time.Date(2026, 7, 27, 15, 4, 5, 0, time.UTC),
+		Level:   WarningLevel,
+		Tabs:    1,
+		File:    "/src/pkg/module.go",
+		Line:    42,
+		Message: "disk usage high\n",
+	}
+}
+
+// TestJSONFormatterFields guards against JSONFormatter dropping or
+// mis-rendering any of Entry's fields, including ones attached via
+// WithFields.
+func TestJSONFormatterFields(t *testing.T) {
+	e := testEntry()
+	e.Fields = map[string]interface{}{"host": "db-1"}
+
+	out := JSONFormatter{}.Format(e)
+
+	var obj map[string]interface{}
+	if err := json.Unmarshal(out, &obj); err != nil {
+		t.Fatalf("JSONFormatter produced invalid JSON: %v\noutput: %s", err, out)
+	}
+
+	want := map[string]interface{}{
+		"time":    e.Time.Format(time.RFC3339Nano),
+		"level":   "WARNING",
+		"source":  "module.go:42",
+		"message": "disk usage high",
+		"host":    "db-1",
+	}
+	for k, v := range want {
+		if obj[k] != v {
+			t.Errorf("obj[%q] = %v, want %v", k, obj[k], v)
+		}
+	}
+}
+
+// TestPatternFormatter guards against PatternFormatter mis-substituting
+// or skipping any of its supported tokens.
+func TestPatternFormatter(t *testing.T) {
+	e := testEntry()
+
+	p := PatternFormatter{Pattern: "[%D %T] %L %S %t%M"}
+	out := p.Format(e)
+
+	want := "[2026-07-27 15:04:05] WARNING module.go:42 \tdisk usage high\n"
+	if string(out) != want {
+		t.Errorf("Format() = %q, want %q", out, want)
+	}
+}
+
+// TestPatternFormatterUnknownToken guards against an unrecognized token
+// swallowing the '%' and the following character instead of echoing them
+// back verbatim.
+func TestPatternFormatterUnknownToken(t *testing.T) {
+	p := PatternFormatter{Pattern: "%Z"}
+	out := p.Format(testEntry())
+
+	want := "%Z\n"
+	if string(out) != want {
+		t.Errorf("Format() = %q, want %q", out, want)
+	}
+}
+
+// TestWithFieldsMerges guards against WithFields failing to merge onto an
+// existing field set, and against it mutating the original Logger's
+// fields when called a second time.
+func TestWithFieldsMerges(t *testing.T) {
+	sink := &captureSink{}
+	base := &Logger{sinks: []Sink{sink}}
+
+	withHost := base.WithFields(map[string]interface{}{"host": "db-1"})
+	withBoth := withHost.WithFields(map[string]interface{}{"request_id": "abc"})
+
+	withBoth.Info("query slow")
+
+	if sink.entry.Fields["host"] != "db-1" {
+		t.Errorf("Fields[host] = %v, want %q", sink.entry.Fields["host"], "db-1")
+	}
+	if sink.entry.Fields["request_id"] != "abc" {
+		t.Errorf("Fields[request_id] = %v, want %q", sink.entry.Fields["request_id"], "abc")
+	}
+
+	if _, ok := withHost.fields["request_id"]; ok {
+		t.Error("withHost.fields mutated by a later WithFields call on its copy")
+	}
+}
@@ -0,0 +1,194 @@
+package logger
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Level identifies the severity of a log message. Severities are ordered,
+// and follow the glog convention: a message logged at a given severity is
+// also written to the log files of every lower severity, so that, for
+// example, the INFO log file is a superset of every other file.
+type Level int
+
+// The severities supported by Logger, from least to most severe. They are
+// named with a Level suffix to avoid colliding with the package-level
+// Info/Warning/Error/Fatal logging functions.
+const (
+	InfoLevel Level = iota
+	WarningLevel
+	ErrorLevel
+	FatalLevel
+)
+
+// levels lists every severity in increasing order, and is used to drive
+// the per-level file fan-out in Logger.print.
+var levels = []Level{InfoLevel, WarningLevel, ErrorLevel, FatalLevel}
+
+// String returns the name used both for the level's log file suffix and
+// its package-level wrapper functions, e.g. "WARNING".
+func (lv Level) String() string {
+	switch lv {
+	case InfoLevel:
+		return "INFO"
+	case WarningLevel:
+		return "WARNING"
+	case ErrorLevel:
+		return "ERROR"
+	case FatalLevel:
+		return "FATAL"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+var (
+	verbosity int
+
+	vmoduleMu sync.RWMutex
+	vmodule   = map[string]int{}
+)
+
+// SetVerbosity sets the default verbosity level used by V when the calling
+// source file isn't covered by a more specific SetVModule entry.
+func SetVerbosity(level int) {
+	vmoduleMu.Lock()
+	verbosity = level
+	vmoduleMu.Unlock()
+}
+
+// SetVModule overrides the verbosity level for specific source files,
+// following the glog -vmodule flag syntax: a comma-separated list of
+// pattern=level pairs, e.g. "logger.go=2,foo.go=3". Patterns are matched,
+// via filepath.Match, against the base name of the file calling V.
+//
+// SetVModule can also be primed from the LOGGER_VMODULE environment
+// variable, read once by Init.
+func SetVModule(spec string) error {
+	m := make(map[string]int)
+	if spec != "" {
+		for _, entry := range strings.Split(spec, ",") {
+			pair := strings.SplitN(entry, "=", 2)
+			if len(pair) != 2 {
+				return fmt.Errorf("logger: invalid vmodule entry %q", entry)
+			}
+			lvl, err := strconv.Atoi(pair[1])
+			if err != nil {
+				return fmt.Errorf("logger: invalid vmodule level in %q: %v", entry, err)
+			}
+			m[pair[0]] = lvl
+		}
+	}
+
+	vmoduleMu.Lock()
+	vmodule = m
+	vmoduleMu.Unlock()
+	return nil
+}
+
+// moduleVerbosity looks up the verbosity override for the given source
+// file name, returning ok=false when no vmodule pattern matches it.
+func moduleVerbosity(file string) (level int, ok bool) {
+	vmoduleMu.RLock()
+	defer vmoduleMu.RUnlock()
+
+	for pattern, lvl := range vmodule {
+		if matched, _ := filepath.Match(pattern, file); matched {
+			return lvl, true
+		}
+	}
+	return 0, false
+}
+
+// Verbose is returned by V and acts as a boolean gate: its Info methods
+// are no-ops unless the requested verbosity level is enabled.
+type Verbose bool
+
+// Info logs to the INFO file of the standard logger if v is true.
+func (v Verbose) Info(msg ...interface{}) {
+	if v {
+		std().print(InfoLevel, fmt.Sprint(msg...), 2)
+	}
+}
+
+// Infof logs to the INFO file of the standard logger if v is true.
+func (v Verbose) Infof(format string, msg ...interface{}) {
+	if v {
+		std().print(InfoLevel, fmt.Sprintf(format, msg...), 2)
+	}
+}
+
+// Infoln logs to the INFO file of the standard logger if v is true.
+func (v Verbose) Infoln(msg ...interface{}) {
+	if v {
+		std().print(InfoLevel, fmt.Sprintln(msg...), 2)
+	}
+}
+
+// V reports whether verbosity level is enabled for the standard logger at
+// the call site, returning a Verbose gate that Info/Infof/Infoln can be
+// called on unconditionally.
+func V(level int) Verbose {
+	return std().v(level, 2)
+}
+
+// V reports whether verbosity level is enabled for l at the call site.
+// The returned Verbose's Info methods log through the standard logger,
+// not through l.
+func (l *Logger) V(level int) Verbose {
+	return l.v(level, 2)
+}
+
+// v is the shared implementation behind the exported V methods, kept
+// separate so the runtime.Caller depth used to resolve the calling file
+// stays correct regardless of how many wrappers are involved.
+func (l *Logger) v(level, calldepth int) Verbose {
+	file := callerFile(calldepth + 1)
+	if lvl, ok := moduleVerbosity(file); ok {
+		return Verbose(lvl >= level)
+	}
+
+	vmoduleMu.RLock()
+	defer vmoduleMu.RUnlock()
+	return Verbose(verbosity >= level)
+}
+
+// callerFile returns the base name of the source file calldepth frames
+// above callerFile itself, or "" if it can't be determined.
+func callerFile(calldepth int) string {
+	_, file, _, ok := runtime.Caller(calldepth)
+	if !ok {
+		return ""
+	}
+	return filepath.Base(file)
+}
+
+// vmoduleEnvVar is the environment variable Init reads to prime
+// SetVModule, so verbosity can be raised without changing code.
+const vmoduleEnvVar = "LOGGER_VMODULE"
+
+// verbosityEnvVar is the environment variable Init reads to prime
+// SetVerbosity.
+const verbosityEnvVar = "LOGGER_V"
+
+// initVerbosity primes the package verbosity and vmodule settings from
+// the environment. Errors in LOGGER_VMODULE are reported but non-fatal,
+// since a malformed override shouldn't prevent Init from succeeding.
+func initVerbosity() {
+	if v := os.Getenv(verbosityEnvVar); v != "" {
+		if lvl, err := strconv.Atoi(v); err == nil {
+			SetVerbosity(lvl)
+		}
+	}
+
+	if spec := os.Getenv(vmoduleEnvVar); spec != "" {
+		if err := SetVModule(spec); err != nil {
+			fmt.Println("Logger couldn't parse", vmoduleEnvVar, ":", err)
+		}
+	}
+}
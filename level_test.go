@@ -0,0 +1,23 @@
+package logger
+
+import "testing"
+
+// TestVModuleOpensGateForCallingFile guards against the call depth used by
+// v/callerFile to resolve the calling file drifting away from the actual
+// call site, which would make SetVModule overrides silently never apply
+// to the file they're set for.
+func TestVModuleOpensGateForCallingFile(t *testing.T) {
+	defer SetVModule("")
+
+	if err := SetVModule("level_test.go=2"); err != nil {
+		t.Fatalf("SetVModule: %v", err)
+	}
+
+	l := &Logger{}
+	if !l.V(2) {
+		t.Error("V(2) = false, want true: vmodule override for the calling file should open the gate")
+	}
+	if l.V(3) {
+		t.Error("V(3) = true, want false: requested level exceeds the vmodule override")
+	}
+}
@@ -3,21 +3,36 @@ package logger
 import (
 	"errors"
 	"fmt"
-	"log"
 	"os"
 	"os/user"
 	"path/filepath"
+	"runtime"
+	"sync"
 	"time"
 )
 
 var (
+	// mu guards every package-level mutable field below, including the
+	// registry, so that Init, New, NewWithOptions, Close and the
+	// package-level logging wrappers never observe each other's writes
+	// half-done.
+	mu          sync.RWMutex
 	stdlogger   *Logger
 	logPath     string
 	programName string
 	enabled     = false
-	fileSet     = make(set)
+	loggers     = newRegistry()
 )
 
+// std returns the current standard logger, synchronizing with Init so
+// concurrent package-level calls never read stdlogger while it's being
+// assigned.
+func std() *Logger {
+	mu.RLock()
+	defer mu.RUnlock()
+	return stdlogger
+}
+
 // Init initializes the default logger to the current timestamp
 // and given program name, pointing to the file std.log, the standard
 // logging file.
@@ -27,6 +42,9 @@ var (
 // the name of the program to be ran, so as to place the log
 // files in the correct folder.
 func Init(progName string) error {
+	mu.Lock()
+	defer mu.Unlock()
+
 	if enabled {
 		return errors.New("logger already initialized")
 	}
@@ -52,83 +70,161 @@ func Init(progName string) error {
 	}
 
 	enabled = true
+	initVerbosity()
+
+	l := &Logger{}
+	if err := loggers.register(logPath, "std", l); err != nil {
+		enabled = false
+		return err
+	}
 
-	stdlogger, err = New("std")
+	sinks, err := newFileSinks("std")
+	if err != nil {
+		loggers.unregister("std")
+		enabled = false
+		return err
+	}
 
-	return err
+	l.sinks = sinks
+	stdlogger = l
+
+	return nil
 }
 
 /** Standard logger wrappers **/
 
 // IncTab increases the indent level of the standard logger by 1 tab character.
 func IncTab() {
-	stdlogger.IncTab()
+	std().IncTab()
 }
 
 // DecTab decreases the indent level of the standard logger by 1 tab character.
 func DecTab() {
-	stdlogger.DecTab()
+	std().DecTab()
 }
 
 // SetTab sets the indent level of the standard logger to i tab characters. The given
 // number i has to be non-negative.
 func SetTab(i int) {
-	stdlogger.SetTab(i)
+	std().SetTab(i)
 }
 
 // TabLevel returns the current indentation level of the standard logger.
 func TabLevel() int {
-	return stdlogger.tabLevel
+	return std().TabLevel()
+}
+
+// Info logs to the INFO log file of the standard logger. Arguments are
+// handled in the manner of fmt.Print().
+func Info(v ...interface{}) {
+	std().print(InfoLevel, fmt.Sprint(v...), 2)
+}
+
+// Infof logs to the INFO log file of the standard logger. Arguments are
+// handled in the manner of fmt.Printf().
+func Infof(format string, v ...interface{}) {
+	std().print(InfoLevel, fmt.Sprintf(format, v...), 2)
+}
+
+// Infoln logs to the INFO log file of the standard logger. Arguments are
+// handled in the manner of fmt.Println().
+func Infoln(v ...interface{}) {
+	std().print(InfoLevel, fmt.Sprintln(v...), 2)
+}
+
+// Warning logs to the WARNING log file of the standard logger, and also to
+// its INFO file. Arguments are handled in the manner of fmt.Print().
+func Warning(v ...interface{}) {
+	std().print(WarningLevel, fmt.Sprint(v...), 2)
+}
+
+// Warningf logs to the WARNING log file of the standard logger, and also to
+// its INFO file. Arguments are handled in the manner of fmt.Printf().
+func Warningf(format string, v ...interface{}) {
+	std().print(WarningLevel, fmt.Sprintf(format, v...), 2)
+}
+
+// Warningln logs to the WARNING log file of the standard logger, and also to
+// its INFO file. Arguments are handled in the manner of fmt.Println().
+func Warningln(v ...interface{}) {
+	std().print(WarningLevel, fmt.Sprintln(v...), 2)
+}
+
+// Error logs to the ERROR log file of the standard logger, and also to its
+// WARNING and INFO files. Arguments are handled in the manner of fmt.Print().
+func Error(v ...interface{}) {
+	std().print(ErrorLevel, fmt.Sprint(v...), 2)
+}
+
+// Errorf logs to the ERROR log file of the standard logger, and also to its
+// WARNING and INFO files. Arguments are handled in the manner of fmt.Printf().
+func Errorf(format string, v ...interface{}) {
+	std().print(ErrorLevel, fmt.Sprintf(format, v...), 2)
 }
 
-// Fatal is equivalent to Print() followed by a call to os.Exit(1).
+// Errorln logs to the ERROR log file of the standard logger, and also to its
+// WARNING and INFO files. Arguments are handled in the manner of fmt.Println().
+func Errorln(v ...interface{}) {
+	std().print(ErrorLevel, fmt.Sprintln(v...), 2)
+}
+
+// Fatal is like Error(), but logs at FATAL severity, so the message also
+// reaches the FATAL log file; it then calls os.Exit(1).
 func Fatal(v ...interface{}) {
-	stdlogger.fatal(fmt.Sprint(v), 2)
+	std().fatal(fmt.Sprint(v...), 2)
 }
 
-// Fatalf is equivalent to Printf() followed by a call to os.Exit(1).
+// Fatalf is like Errorf(), but logs at FATAL severity, so the message also
+// reaches the FATAL log file; it then calls os.Exit(1).
 func Fatalf(format string, v ...interface{}) {
-	stdlogger.fatal(fmt.Sprintf(format, v...), 2)
+	std().fatal(fmt.Sprintf(format, v...), 2)
 }
 
-// Fatalln is equivalent to Println() followed by a call to os.Exit(1).
+// Fatalln is like Errorln(), but logs at FATAL severity, so the message
+// also reaches the FATAL log file; it then calls os.Exit(1).
 func Fatalln(v ...interface{}) {
-	stdlogger.fatal(fmt.Sprintln(v...), 2)
+	std().fatal(fmt.Sprintln(v...), 2)
 }
 
-// Panic is equivalent to Print() followed by a call to panic().
+// Panic is equivalent to Error() followed by a call to panic().
 func Panic(v ...interface{}) {
-	stdlogger.panic(fmt.Sprint(v), 2)
+	std().panic(fmt.Sprint(v...), 2)
 }
 
-// Panicf is equivalent to Printf() followed by a call to panic().
+// Panicf is equivalent to Errorf() followed by a call to panic().
 func Panicf(format string, v ...interface{}) {
-	stdlogger.panic(fmt.Sprintf(format, v...), 2)
+	std().panic(fmt.Sprintf(format, v...), 2)
 }
 
-// Panicln is equivalent to Println() followed by a call to panic().
+// Panicln is equivalent to Errorln() followed by a call to panic().
 func Panicln(v ...interface{}) {
-	stdlogger.panic(fmt.Sprintln(v...), 2)
+	std().panic(fmt.Sprintln(v...), 2)
 }
 
-// Print calls Output() to print to the standart logger. Arguments are
-// handled in the manner of fmt.Print()
+// Print is equivalent to Info() and is kept for backwards compatibility with
+// code written before severities were introduced.
 func Print(v ...interface{}) {
-	stdlogger.print(fmt.Sprint(v), 2)
+	std().print(InfoLevel, fmt.Sprint(v...), 2)
 }
 
-// Printf calls Output() to print to the standart logger. Arguments are
-// handled in the manner of fmt.Printf()
+// Printf is equivalent to Infof() and is kept for backwards compatibility
+// with code written before severities were introduced.
 func Printf(format string, v ...interface{}) {
-	fmt.Println("Format string: ", format)
-	fmt.Println("Arguments:  ", v)
-	stdlogger.print(fmt.Sprintf(format, v...), 2)
+	std().print(InfoLevel, fmt.Sprintf(format, v...), 2)
 }
 
-// Println calls Output() to print to the standart logger. Arguments are
-// handled in the manner of fmt.Println()
+// Println is equivalent to Infoln() and is kept for backwards compatibility
+// with code written before severities were introduced.
 func Println(v ...interface{}) {
-	stdlogger.print(fmt.Sprintln(v...), 2)
+	std().print(InfoLevel, fmt.Sprintln(v...), 2)
+}
+
+// logFilePath returns the path of the log file named logName plus the
+// ".log" extension, inside the current log path given by the timestamp of
+// the initialization of the package and the name of the program that is
+// being logged.
+func logFilePath(logName string) string {
+	return filepath.Join(logPath, logName+".log")
 }
 
 // newLogFile creates and returns a new log file with the given
@@ -139,7 +235,7 @@ func Println(v ...interface{}) {
 // The error returned can be any of the errors that os.Create()
 // returns, returned when the file creation fails.
 func newLogFile(logName string) (*os.File, error) {
-	file, err := os.Create(filepath.Join(logPath, logName+".log"))
+	file, err := os.Create(logFilePath(logName))
 
 	if err != nil {
 		fmt.Println("Logger couldn't create file")
@@ -149,10 +245,19 @@ func newLogFile(logName string) (*os.File, error) {
 	return file, nil
 }
 
-// A Logger can be used to log messages to a file using the standard Go Logger
-// methods. Multiple loggers can be present during a program's run. In fact,
-// the intended usage is to group log messages with similar purposes in
-// different loggers, so as to avoid clutter and better organize logs.
+// A Logger can be used to log messages to a file using familiar Print/Fatal/
+// Panic-style methods. Multiple loggers can be present during a program's
+// run. In fact, the intended usage is to group log messages with similar
+// purposes in different loggers, so as to avoid clutter and better
+// organize logs.
+//
+// Every Logger delivers each message it logs to a list of Sinks: by
+// default, one FileSink per severity (e.g. std.INFO.log, std.WARNING.log,
+// std.ERROR.log, std.FATAL.log), with a message logged at a given
+// severity also reaching every Sink filtering for a lower severity,
+// mirroring the behaviour of glog. Additional Sinks, such as StderrSink,
+// SyslogSink or NetSink, can be passed to New or added later with
+// AddSink.
 //
 // Furthermore, a tab level can be specified to indent lines. Common
 // usage is increasing the tab level before calling an important function, and
@@ -174,32 +279,205 @@ func newLogFile(logName string) (*os.File, error) {
 // The tab characted can be set to any character to provide better visibility
 // of indented log entries.
 type Logger struct {
-	gologger *log.Logger
+	mu     sync.Mutex
+	sinks  []Sink
+	fields map[string]interface{}
+	async  *asyncState
+
 	tabLevel int
 }
 
-// New initializes and returns a new Logger pointing to a file located in
-// the current timestamped directory, with the given filename and the
-// ".log" extension.
-func New(filename string) (*Logger, error) {
+// newRegistered reserves filename in the registry and returns a fresh,
+// otherwise-empty Logger for it, failing if the package isn't initialized
+// or filename collides with an existing Logger's name or, once resolved
+// through any symlinks, its underlying file.
+func newRegistered(filename string) (*Logger, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
 	if !enabled {
 		return nil, errors.New("Logger not initialized, unable to create new Logger object.")
 	}
 
-	if fileSet.contains(filename) {
-		return nil, errors.New("Another logger is already logging to that file")
+	l := &Logger{}
+	if err := loggers.register(logPath, filename, l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// unregister frees filename in the registry, for use when sink creation
+// fails partway through New or NewWithOptions.
+func unregister(filename string) {
+	mu.Lock()
+	loggers.unregister(filename)
+	mu.Unlock()
+}
+
+// newFileSinks creates one FileSink per severity for filename, in the
+// current log path, in ascending severity order.
+func newFileSinks(filename string) ([]Sink, error) {
+	sinks := make([]Sink, 0, len(levels))
+	for _, lv := range levels {
+		file, err := newLogFile(filename + "." + lv.String())
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, NewFileSink(file, lv, defaultFormatter))
 	}
+	return sinks, nil
+}
 
-	file, err := newLogFile(filename)
+// New initializes and returns a new Logger delivering to one FileSink per
+// severity, pointing at files in the current timestamped directory named
+// after the given filename plus the severity and the ".log" extension,
+// e.g. filename.INFO.log. Any extraSinks are added alongside them. The
+// FileSinks are given the current default Formatter; see
+// SetDefaultFormatter.
+func New(filename string, extraSinks ...Sink) (*Logger, error) {
+	l, err := newRegistered(filename)
 	if err != nil {
 		return nil, err
 	}
 
-	gologger := log.New(file, "", log.LstdFlags|log.Lshortfile)
+	sinks, err := newFileSinks(filename)
+	if err != nil {
+		unregister(filename)
+		return nil, err
+	}
 
-	fileSet.add(filename)
+	l.sinks = append(sinks, extraSinks...)
+	return l, nil
+}
 
-	return &Logger{gologger, 0}, nil
+// NewWithOptions is like New, but backs each severity's FileSink with a
+// rotating file writer driven by opts, rolling the active file over to a
+// timestamped archive (e.g. filename.INFO.log.2006-01-02T15-04-05.gz when
+// opts.Compress is set) once it outgrows the configured size, age, or
+// daily boundary.
+func NewWithOptions(filename string, opts Options, extraSinks ...Sink) (*Logger, error) {
+	l, err := newRegistered(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	sinks := make([]Sink, 0, len(levels)+len(extraSinks))
+	for _, lv := range levels {
+		file, err := newRotatingFile(logFilePath(filename+"."+lv.String()), opts)
+		if err != nil {
+			unregister(filename)
+			return nil, err
+		}
+		sinks = append(sinks, NewFileSink(file, lv, defaultFormatter))
+	}
+	sinks = append(sinks, extraSinks...)
+
+	l.sinks = sinks
+	return l, nil
+}
+
+// Loggers returns the names of every Logger currently registered, in no
+// particular order. The name "std" is included once Init has succeeded;
+// Close refuses to remove it.
+func Loggers() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return loggers.names()
+}
+
+// Close closes every Sink of the Logger registered under name and removes
+// it from the registry, freeing name and its underlying files for reuse by
+// a later New or NewWithOptions call. If the Logger is running in async
+// mode, Close also stops its background goroutine and deregisters it from
+// DrainAll. It returns an error if no Logger is registered under name, or
+// if name is "std": the package-level wrappers (Info, Warning, Fatal, ...)
+// always log through the standard logger, so closing it would leave them
+// with nothing to call.
+func Close(name string) error {
+	if name == "std" {
+		return errors.New("logger: the standard logger can't be closed")
+	}
+
+	mu.Lock()
+	l, ok := loggers.get(name)
+	if !ok {
+		mu.Unlock()
+		return fmt.Errorf("logger: no Logger named %q", name)
+	}
+	loggers.unregister(name)
+	mu.Unlock()
+
+	l.stopAsync()
+	unregisterAsync(l)
+	return l.closeSinks()
+}
+
+// closeSinks closes every Sink of l, returning the first error
+// encountered, if any, after attempting to close them all.
+func (l *Logger) closeSinks() error {
+	l.mu.Lock()
+	sinks := l.sinks
+	l.mu.Unlock()
+
+	var first error
+	for _, sink := range sinks {
+		if err := sink.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
+
+// AddSink appends sink to l's sink list. Subsequent log calls are
+// delivered to it, in addition to any sinks already present.
+func (l *Logger) AddSink(sink Sink) {
+	l.mu.Lock()
+	l.sinks = append(l.sinks, sink)
+	l.mu.Unlock()
+}
+
+// formatterSetter is implemented by Sinks whose rendering can be changed
+// after construction, such as FileSink, StderrSink and NetSink.
+type formatterSetter interface {
+	SetFormatter(Formatter)
+}
+
+// SetFormatter changes the Formatter used by every Sink of l that
+// supports one.
+func (l *Logger) SetFormatter(f Formatter) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, sink := range l.sinks {
+		if fs, ok := sink.(formatterSetter); ok {
+			fs.SetFormatter(f)
+		}
+	}
+}
+
+// WithFields returns a copy of l that additionally attaches the given
+// key/value pairs to every Entry it logs. JSONFormatter and TextFormatter
+// render these fields; PatternFormatter has no token for them and leaves
+// them out. The original Logger l is left untouched.
+func (l *Logger) WithFields(fields map[string]interface{}) *Logger {
+	merged := make(map[string]interface{}, len(l.fields)+len(fields))
+	for k, v := range l.fields {
+		merged[k] = v
+	}
+	for k, v := range fields {
+		merged[k] = v
+	}
+
+	l.mu.Lock()
+	sinks := append([]Sink(nil), l.sinks...)
+	async := l.async
+	l.mu.Unlock()
+
+	return &Logger{
+		sinks:    sinks,
+		fields:   merged,
+		async:    async,
+		tabLevel: l.tabLevel,
+	}
 }
 
 // IncTab increases the indent level of the Logger l by 1 tab character.
@@ -237,71 +515,166 @@ func (l *Logger) tabs() string {
 	return string(slice)
 }
 
-// Fatal is equivalent to l.Print() followed by a call to os.Exit(1).
+// Info logs to the INFO file of l. Arguments are handled in the manner of
+// fmt.Print.
+func (l *Logger) Info(v ...interface{}) {
+	l.print(InfoLevel, fmt.Sprint(v...), 2)
+}
+
+// Infof logs to the INFO file of l. Arguments are handled in the manner of
+// fmt.Printf.
+func (l *Logger) Infof(format string, v ...interface{}) {
+	l.print(InfoLevel, fmt.Sprintf(format, v...), 2)
+}
+
+// Infoln logs to the INFO file of l. Arguments are handled in the manner of
+// fmt.Println.
+func (l *Logger) Infoln(v ...interface{}) {
+	l.print(InfoLevel, fmt.Sprintln(v...), 2)
+}
+
+// Warning logs to the WARNING file of l, and also to its INFO file.
+// Arguments are handled in the manner of fmt.Print.
+func (l *Logger) Warning(v ...interface{}) {
+	l.print(WarningLevel, fmt.Sprint(v...), 2)
+}
+
+// Warningf logs to the WARNING file of l, and also to its INFO file.
+// Arguments are handled in the manner of fmt.Printf.
+func (l *Logger) Warningf(format string, v ...interface{}) {
+	l.print(WarningLevel, fmt.Sprintf(format, v...), 2)
+}
+
+// Warningln logs to the WARNING file of l, and also to its INFO file.
+// Arguments are handled in the manner of fmt.Println.
+func (l *Logger) Warningln(v ...interface{}) {
+	l.print(WarningLevel, fmt.Sprintln(v...), 2)
+}
+
+// Error logs to the ERROR file of l, and also to its WARNING and INFO
+// files. Arguments are handled in the manner of fmt.Print.
+func (l *Logger) Error(v ...interface{}) {
+	l.print(ErrorLevel, fmt.Sprint(v...), 2)
+}
+
+// Errorf logs to the ERROR file of l, and also to its WARNING and INFO
+// files. Arguments are handled in the manner of fmt.Printf.
+func (l *Logger) Errorf(format string, v ...interface{}) {
+	l.print(ErrorLevel, fmt.Sprintf(format, v...), 2)
+}
+
+// Errorln logs to the ERROR file of l, and also to its WARNING and INFO
+// files. Arguments are handled in the manner of fmt.Println.
+func (l *Logger) Errorln(v ...interface{}) {
+	l.print(ErrorLevel, fmt.Sprintln(v...), 2)
+}
+
+// Fatal is like l.Error(), but logs at FATAL severity, so the message also
+// reaches l's FATAL file; it then calls os.Exit(1).
 func (l *Logger) Fatal(v ...interface{}) {
-	l.fatal(fmt.Sprint(v), 2)
+	l.fatal(fmt.Sprint(v...), 2)
 }
 
-// Fatalf is equivalent to l.Printf() followed by a call to os.Exit(1).
+// Fatalf is like l.Errorf(), but logs at FATAL severity, so the message
+// also reaches l's FATAL file; it then calls os.Exit(1).
 func (l *Logger) Fatalf(format string, v ...interface{}) {
-	l.fatal(fmt.Sprintf(format, v), 2)
+	l.fatal(fmt.Sprintf(format, v...), 2)
 }
 
-// Fatalln is equivalent to l.Println() followed by a call to os.Exit(1).
+// Fatalln is like l.Errorln(), but logs at FATAL severity, so the message
+// also reaches l's FATAL file; it then calls os.Exit(1).
 func (l *Logger) Fatalln(v ...interface{}) {
 	l.fatal(fmt.Sprintln(v...), 2)
 }
 
-// Panic is equivalent to l.Print() followed by a call to panic().
+// Panic is equivalent to l.Error() followed by a call to panic().
 func (l *Logger) Panic(v ...interface{}) {
-	l.panic(fmt.Sprint(v), 2)
+	l.panic(fmt.Sprint(v...), 2)
 }
 
-// Panicf is equivalent to l.Printf() followed by a call to panic().
+// Panicf is equivalent to l.Errorf() followed by a call to panic().
 func (l *Logger) Panicf(format string, v ...interface{}) {
 	l.panic(fmt.Sprintf(format, v...), 2)
 }
 
-// Panicln is equivalent to l.Println() followed by a call to panic().
+// Panicln is equivalent to l.Errorln() followed by a call to panic().
 func (l *Logger) Panicln(v ...interface{}) {
 	l.panic(fmt.Sprintln(v...), 2)
 }
 
-// Print calls l.Output to print to the logger. Arguments are handled in
-// the manner of fmt.Print.
+// Print is equivalent to l.Info() and is kept for backwards compatibility
+// with code written before severities were introduced.
 func (l *Logger) Print(v ...interface{}) {
-	l.print(fmt.Sprint(v), 2)
+	l.print(InfoLevel, fmt.Sprint(v...), 2)
 }
 
-// Printf calls l.Output to print to the logger. Arguments are handled in
-// the manner of fmt.Printf.
+// Printf is equivalent to l.Infof() and is kept for backwards compatibility
+// with code written before severities were introduced.
 func (l *Logger) Printf(format string, v ...interface{}) {
-	l.print(fmt.Sprintf(format, v...), 2)
+	l.print(InfoLevel, fmt.Sprintf(format, v...), 2)
 }
 
-// Println calls l.Output to print to the logger. Arguments are handled in
-// the manner of fmt.Println.
+// Println is equivalent to l.Infoln() and is kept for backwards
+// compatibility with code written before severities were introduced.
 func (l *Logger) Println(v ...interface{}) {
-	l.print(fmt.Sprintln(v...), 2)
+	l.print(InfoLevel, fmt.Sprintln(v...), 2)
 }
 
 // fatal is a helper method for all Fatal[f|ln] methods to call. Preserves
 // correct call depth.
 func (l *Logger) fatal(v string, calldepth int) {
-	l.print(v, calldepth+1)
+	l.print(FatalLevel, v, calldepth+1)
+	DrainAll()
 	os.Exit(1)
 }
 
 // panic is a helper method for all Panic[f|ln] methods to call. Preserves
 // correct call depth.
 func (l *Logger) panic(v string, calldepth int) {
-	l.print(v, calldepth+1)
+	l.print(ErrorLevel, v, calldepth+1)
+	DrainAll()
 	panic(v)
 }
 
-// print is a helper method for all Print[f|ln] methods and others to call.
-// Preserves correct call depth.
-func (l *Logger) print(v string, calldepth int) {
-	str := fmt.Sprint(v)
-	l.gologger.Output(calldepth+1, str)
+// print is a helper method for all severity-tagged methods to call. It
+// builds an Entry from v and the caller's location and either delivers it
+// to every Sink of l, or, if l is running in async mode, hands it off to
+// the background goroutine to do the same. Preserves correct call depth.
+func (l *Logger) print(level Level, v string, calldepth int) {
+	_, file, line, ok := runtime.Caller(calldepth)
+	if !ok {
+		file = "???"
+	}
+
+	entry := Entry{
+		Time:    time.Now(),
+		Level:   level,
+		Tabs:    l.tabLevel,
+		File:    file,
+		Line:    line,
+		Message: v,
+		Fields:  l.fields,
+	}
+
+	if a := l.getAsync(); a != nil {
+		a.enqueue(entry)
+		return
+	}
+
+	l.writeToSinks(entry)
+}
+
+// writeToSinks delivers entry to every Sink of l. It's called directly by
+// print for synchronous Loggers, and by the async goroutine for ones
+// running in async mode.
+func (l *Logger) writeToSinks(entry Entry) {
+	l.mu.Lock()
+	sinks := l.sinks
+	l.mu.Unlock()
+
+	for _, sink := range sinks {
+		if err := sink.Write(entry); err != nil {
+			fmt.Println("Logger sink failed to write:", err)
+		}
+	}
 }
@@ -0,0 +1,39 @@
+package logger
+
+import "runtime"
+import "testing"
+
+// captureSink is a Sink that records the last Entry written to it, for
+// assertions in tests.
+type captureSink struct {
+	entry Entry
+}
+
+func (s *captureSink) Write(e Entry) error {
+	s.entry = e
+	return nil
+}
+
+func (s *captureSink) Close() error { return nil }
+
+// TestPrintRecordsCallerLocation guards against the call depth used by
+// print to resolve Entry.File/Entry.Line drifting away from the actual
+// call site, e.g. by pointing one frame too far up the stack.
+func TestPrintRecordsCallerLocation(t *testing.T) {
+	sink := &captureSink{}
+	l := &Logger{sinks: []Sink{sink}}
+
+	_, wantFile, callerLine, ok := runtime.Caller(0)
+	if !ok {
+		t.Fatal("runtime.Caller(0) failed")
+	}
+	l.Info("hello")
+	wantLine := callerLine + 4 // l.Info call, 4 lines below runtime.Caller(0)
+
+	if sink.entry.File != wantFile {
+		t.Errorf("Entry.File = %q, want %q", sink.entry.File, wantFile)
+	}
+	if sink.entry.Line != wantLine {
+		t.Errorf("Entry.Line = %d, want %d", sink.entry.Line, wantLine)
+	}
+}
@@ -0,0 +1,146 @@
+package logger
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+// NetSink streams entries at or above MinLevel to a remote collector over
+// TCP or UDP, rendered with a Formatter that can be swapped at any time
+// via SetFormatter. If the connection drops, or was never established,
+// it's retried in the background with exponential backoff; entries
+// written while disconnected are dropped.
+type NetSink struct {
+	MinLevel Level
+
+	network string
+	addr    string
+
+	mu        sync.Mutex
+	formatter Formatter
+	conn      net.Conn
+	closed    bool
+	done      chan struct{}
+}
+
+// NewNetSink dials addr over network ("tcp" or "udp") and returns a
+// NetSink delivering entries at or above minLevel to it. The dial happens
+// in the background, so NewNetSink doesn't block or fail if the collector
+// is briefly unreachable.
+func NewNetSink(network, addr string, minLevel Level, formatter Formatter) *NetSink {
+	if formatter == nil {
+		formatter = defaultFormatter
+	}
+
+	s := &NetSink{
+		MinLevel:  minLevel,
+		formatter: formatter,
+		network:   network,
+		addr:      addr,
+		done:      make(chan struct{}),
+	}
+	go s.connectWithBackoff()
+	return s
+}
+
+// connectWithBackoff dials s.addr, retrying with exponential backoff
+// capped at 30s, until it succeeds or s is closed.
+func (s *NetSink) connectWithBackoff() {
+	backoff := time.Second
+	const maxBackoff = 30 * time.Second
+
+	for {
+		select {
+		case <-s.done:
+			return
+		default:
+		}
+
+		conn, err := net.Dial(s.network, s.addr)
+		if err == nil {
+			s.mu.Lock()
+			closed := s.closed
+			if !closed {
+				s.conn = conn
+			}
+			s.mu.Unlock()
+			if closed {
+				conn.Close()
+			}
+			return
+		}
+
+		select {
+		case <-s.done:
+			return
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+	}
+}
+
+// Write implements Sink. If s isn't currently connected, the entry is
+// dropped. A failed write drops the connection and kicks off a
+// reconnection in the background.
+func (s *NetSink) Write(e Entry) error {
+	if e.Level < s.MinLevel {
+		return nil
+	}
+
+	s.mu.Lock()
+	conn := s.conn
+	formatter := s.formatter
+	s.mu.Unlock()
+
+	if conn == nil {
+		return nil
+	}
+
+	if _, err := conn.Write(formatter.Format(e)); err != nil {
+		s.mu.Lock()
+		reconnect := s.conn == conn && !s.closed
+		if s.conn == conn {
+			s.conn = nil
+		}
+		s.mu.Unlock()
+
+		conn.Close()
+		if reconnect {
+			go s.connectWithBackoff()
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Close implements Sink, stopping any in-progress reconnection attempt.
+func (s *NetSink) Close() error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	close(s.done)
+	conn := s.conn
+	s.conn = nil
+	s.mu.Unlock()
+
+	if conn == nil {
+		return nil
+	}
+	return conn.Close()
+}
+
+// SetFormatter changes the Formatter s renders entries with.
+func (s *NetSink) SetFormatter(f Formatter) {
+	s.mu.Lock()
+	s.formatter = f
+	s.mu.Unlock()
+}
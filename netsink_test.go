@@ -0,0 +1,148 @@
+package logger
+
+import (
+	"bufio"
+	"net"
+	"testing"
+	"time"
+)
+
+// waitForConn polls until s has an established connection or the timeout
+// elapses, returning it.
+func waitForConn(t *testing.T, s *NetSink, timeout time.Duration) net.Conn {
+	t.Helper()
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		s.mu.Lock()
+		conn := s.conn
+		s.mu.Unlock()
+		if conn != nil {
+			return conn
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("NetSink never established a connection")
+	return nil
+}
+
+// TestNetSinkWritesOverTCP guards against NewNetSink's background dial
+// and Write's delivery over an established connection.
+func TestNetSinkWritesOverTCP(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	defer ln.Close()
+
+	acceptedCh := make(chan net.Conn, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err == nil {
+			acceptedCh <- conn
+		}
+	}()
+
+	s := NewNetSink("tcp", ln.Addr().String(), InfoLevel, TextFormatter{})
+	defer s.Close()
+
+	server := <-acceptedCh
+	defer server.Close()
+	waitForConn(t, s, time.Second)
+
+	if err := s.Write(Entry{Level: InfoLevel, Message: "hello"}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	server.SetReadDeadline(time.Now().Add(time.Second))
+	line, err := bufio.NewReader(server).ReadString('\n')
+	if err != nil {
+		t.Fatalf("reading from server side: %v", err)
+	}
+	if want := "hello\n"; len(line) < len(want) || line[len(line)-len(want):] != want {
+		t.Errorf("received %q, want it to end in %q", line, want)
+	}
+}
+
+// TestNetSinkBelowMinLevelDropped guards against Write delivering entries
+// below MinLevel.
+func TestNetSinkBelowMinLevelDropped(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	defer ln.Close()
+
+	acceptedCh := make(chan net.Conn, 1)
+	go func() {
+		conn, err := ln.Accept()
+		if err == nil {
+			acceptedCh <- conn
+		}
+	}()
+
+	s := NewNetSink("tcp", ln.Addr().String(), WarningLevel, TextFormatter{})
+	defer s.Close()
+
+	server := <-acceptedCh
+	defer server.Close()
+	waitForConn(t, s, time.Second)
+
+	if err := s.Write(Entry{Level: InfoLevel, Message: "ignored"}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := s.Write(Entry{Level: WarningLevel, Message: "kept"}); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	server.SetReadDeadline(time.Now().Add(time.Second))
+	line, err := bufio.NewReader(server).ReadString('\n')
+	if err != nil {
+		t.Fatalf("reading from server side: %v", err)
+	}
+	if want := "kept\n"; len(line) < len(want) || line[len(line)-len(want):] != want {
+		t.Errorf("first line received = %q, want it to end in %q (below-MinLevel entry should be dropped)", line, want)
+	}
+}
+
+// TestNetSinkReconnectsAfterDrop guards against connectWithBackoff failing
+// to re-establish a connection once the active one is severed.
+func TestNetSinkReconnectsAfterDrop(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %v", err)
+	}
+	defer ln.Close()
+
+	acceptedCh := make(chan net.Conn, 2)
+	go func() {
+		for i := 0; i < 2; i++ {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			acceptedCh <- conn
+		}
+	}()
+
+	s := NewNetSink("tcp", ln.Addr().String(), InfoLevel, TextFormatter{})
+	defer s.Close()
+
+	first := <-acceptedCh
+	waitForConn(t, s, time.Second)
+	first.Close() // sever the connection from the server side
+
+	// The next Write observes the severed connection, fails, and kicks
+	// off a reconnect in the background.
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		s.Write(Entry{Level: InfoLevel, Message: "probe"})
+		select {
+		case second := <-acceptedCh:
+			second.Close()
+			return
+		default:
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatal("NetSink never reconnected after its connection was severed")
+}
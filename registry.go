@@ -0,0 +1,89 @@
+package logger
+
+import (
+	"fmt"
+	"path/filepath"
+)
+
+// registry tracks the canonical path each active Logger writes to, keyed by
+// the name it was created with. It's guarded by the package-level mu, the
+// same lock that protects enabled, logPath, programName and stdlogger, so
+// that a concurrent Init, New and Close can never observe package state
+// halfway updated.
+type registry struct {
+	byName map[string]*Logger
+	path   map[string]string // name -> canonical path
+	byPath map[string]string // canonical path -> name, for collision errors
+}
+
+// newRegistry returns an empty registry.
+func newRegistry() *registry {
+	return &registry{
+		byName: make(map[string]*Logger),
+		path:   make(map[string]string),
+		byPath: make(map[string]string),
+	}
+}
+
+// canonicalPath resolves dir through any symlinks and joins it with name, so
+// two names that reach the same file via a symlinked or ".."-normalized
+// logPath resolve to the same string. It's applied to dir rather than the
+// per-level log files themselves, since those don't exist yet the first
+// time register is asked to reserve a name for them.
+func canonicalPath(dir, name string) (string, error) {
+	resolved, err := filepath.EvalSymlinks(dir)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(resolved, name), nil
+}
+
+// register reserves name for l, failing if either the name or the file it
+// canonically resolves to under dir is already taken. Callers must hold mu
+// for writing.
+func (r *registry) register(dir, name string, l *Logger) error {
+	if _, ok := r.byName[name]; ok {
+		return fmt.Errorf("logger: a Logger named %q already exists", name)
+	}
+
+	path, err := canonicalPath(dir, name)
+	if err != nil {
+		return err
+	}
+
+	if other, ok := r.byPath[path]; ok {
+		return fmt.Errorf("logger: %q resolves to the same file as existing logger %q", name, other)
+	}
+
+	r.byName[name] = l
+	r.path[name] = path
+	r.byPath[path] = name
+	return nil
+}
+
+// unregister frees name and the path it was registered under, if any.
+// Callers must hold mu for writing.
+func (r *registry) unregister(name string) {
+	if path, ok := r.path[name]; ok {
+		delete(r.byPath, path)
+	}
+	delete(r.byName, name)
+	delete(r.path, name)
+}
+
+// get returns the Logger registered under name, if any. Callers must hold
+// mu for reading or writing.
+func (r *registry) get(name string) (*Logger, bool) {
+	l, ok := r.byName[name]
+	return l, ok
+}
+
+// names returns the names of every currently registered Logger, in no
+// particular order. Callers must hold mu for reading or writing.
+func (r *registry) names() []string {
+	names := make([]string, 0, len(r.byName))
+	for name := range r.byName {
+		names = append(names, name)
+	}
+	return names
+}
@@ -0,0 +1,107 @@
+package logger
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestCloseStdRejected guards against Close("std") leaving stdlogger nil:
+// package-level wrappers like Info call std() unconditionally, so a nil
+// stdlogger would panic on next use. Close must refuse to close "std"
+// instead.
+func TestCloseStdRejected(t *testing.T) {
+	sink := &captureSink{}
+	l := &Logger{sinks: []Sink{sink}}
+
+	mu.Lock()
+	stdlogger = l
+	mu.Unlock()
+	defer func() {
+		mu.Lock()
+		stdlogger = nil
+		mu.Unlock()
+	}()
+
+	if err := Close("std"); err == nil {
+		t.Fatal(`Close("std") = nil, want an error`)
+	}
+
+	// Must still be safe to use after the rejected Close.
+	Info("still alive")
+	if sink.entry.Message != "still alive" {
+		t.Errorf("Entry.Message = %q, want %q", sink.entry.Message, "still alive")
+	}
+}
+
+// TestRegistryRejectsDuplicateName guards against register allowing two
+// Loggers to share a name.
+func TestRegistryRejectsDuplicateName(t *testing.T) {
+	r := newRegistry()
+	dir := t.TempDir()
+
+	if err := r.register(dir, "worker", &Logger{}); err != nil {
+		t.Fatalf("first register: %v", err)
+	}
+	if err := r.register(dir, "worker", &Logger{}); err == nil {
+		t.Fatal("second register with the same name = nil error, want one")
+	}
+}
+
+// TestCanonicalPathResolvesSymlink guards against canonicalPath failing
+// to resolve a symlinked directory to its real target, e.g. a "current"
+// symlink pointing at the real timestamped log directory.
+func TestCanonicalPathResolvesSymlink(t *testing.T) {
+	real := t.TempDir()
+	alias := filepath.Join(t.TempDir(), "alias")
+	if err := os.Symlink(real, alias); err != nil {
+		t.Fatalf("Symlink: %v", err)
+	}
+
+	viaReal, err := canonicalPath(real, "worker")
+	if err != nil {
+		t.Fatalf("canonicalPath(real): %v", err)
+	}
+	viaAlias, err := canonicalPath(alias, "worker")
+	if err != nil {
+		t.Fatalf("canonicalPath(alias): %v", err)
+	}
+
+	if viaReal != viaAlias {
+		t.Errorf("canonicalPath(real, %q) = %q, canonicalPath(alias, %q) = %q; want them equal", "worker", viaReal, "worker", viaAlias)
+	}
+}
+
+// TestRegistryRejectsPathTraversalAlias guards against two different
+// names that resolve, once joined and normalized, to the same file both
+// being allowed to register.
+func TestRegistryRejectsPathTraversalAlias(t *testing.T) {
+	r := newRegistry()
+	dir := t.TempDir()
+
+	if err := r.register(dir, "worker", &Logger{}); err != nil {
+		t.Fatalf("first register: %v", err)
+	}
+	if err := r.register(dir, "sub/../worker", &Logger{}); err == nil {
+		t.Fatal(`register("sub/../worker") aliasing an already-registered file = nil error, want one`)
+	}
+}
+
+// TestRegistryUnregisterFreesNameAndPath guards against unregister
+// leaving either the name or its canonical path stuck reserved.
+func TestRegistryUnregisterFreesNameAndPath(t *testing.T) {
+	r := newRegistry()
+	dir := t.TempDir()
+
+	if err := r.register(dir, "worker", &Logger{}); err != nil {
+		t.Fatalf("register: %v", err)
+	}
+	r.unregister("worker")
+
+	if _, ok := r.get("worker"); ok {
+		t.Error("get(\"worker\") found a Logger after unregister")
+	}
+	if err := r.register(dir, "worker", &Logger{}); err != nil {
+		t.Errorf("re-register after unregister: %v", err)
+	}
+}
@@ -0,0 +1,228 @@
+package logger
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Options configures a rotating file backend created via NewWithOptions.
+// The zero value disables rotation entirely, making NewWithOptions behave
+// like New.
+type Options struct {
+	// MaxSize is the size, in bytes, a log file is allowed to reach before
+	// it's rolled over. Zero disables size-based rotation.
+	MaxSize int64
+
+	// MaxAge is how long a log file is kept open before it's rolled over,
+	// regardless of size. Zero disables age-based rotation.
+	MaxAge time.Duration
+
+	// Daily rolls the log file over at the first write after midnight,
+	// local time.
+	Daily bool
+
+	// MaxBackups is the number of archived files kept alongside the active
+	// log file; older archives beyond this count are removed after each
+	// rotation. Zero keeps every archive.
+	MaxBackups int
+
+	// Compress gzips an archived file once it's rolled over.
+	Compress bool
+}
+
+// rotatingFile is a WriteCloser that rolls the file at path over to a
+// timestamped archive once it outgrows opts, and is safe for concurrent
+// use by multiple goroutines.
+type rotatingFile struct {
+	mu   sync.Mutex
+	path string
+	opts Options
+
+	file     *os.File
+	size     int64
+	openedAt time.Time
+	day      int
+}
+
+// newRotatingFile opens path, creating it if necessary, and returns a
+// rotatingFile ready to be written to.
+func newRotatingFile(path string, opts Options) (*rotatingFile, error) {
+	rf := &rotatingFile{path: path, opts: opts}
+	if err := rf.openCurrent(); err != nil {
+		return nil, err
+	}
+	return rf, nil
+}
+
+// openCurrent opens or creates the active file at rf.path, appending to
+// any existing content, and resets the state rotation decisions are based
+// on.
+func (rf *rotatingFile) openCurrent() error {
+	file, err := os.OpenFile(rf.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	rf.file = file
+	rf.size = info.Size()
+	rf.openedAt = time.Now()
+	rf.day = rf.openedAt.YearDay()
+	return nil
+}
+
+// Write implements io.Writer, rotating the underlying file first if p
+// would push it past the configured limits.
+func (rf *rotatingFile) Write(p []byte) (int, error) {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+
+	if rf.shouldRotate(len(p)) {
+		if err := rf.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := rf.file.Write(p)
+	rf.size += int64(n)
+	return n, err
+}
+
+// Close implements io.Closer.
+func (rf *rotatingFile) Close() error {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+	return rf.file.Close()
+}
+
+// shouldRotate reports whether a write of the given length should trigger
+// a rotation before being written.
+func (rf *rotatingFile) shouldRotate(nextWrite int) bool {
+	if rf.opts.MaxSize > 0 && rf.size+int64(nextWrite) > rf.opts.MaxSize {
+		return true
+	}
+	if rf.opts.MaxAge > 0 && time.Since(rf.openedAt) > rf.opts.MaxAge {
+		return true
+	}
+	if rf.opts.Daily && time.Now().YearDay() != rf.day {
+		return true
+	}
+	return false
+}
+
+// rotate closes the active file, archives it alongside itself with a
+// timestamp suffix (optionally gzipped), reopens a fresh active file, and
+// prunes old archives beyond MaxBackups.
+func (rf *rotatingFile) rotate() error {
+	if err := rf.file.Close(); err != nil {
+		return err
+	}
+
+	archived, err := rf.archivePath()
+	if err != nil {
+		return err
+	}
+	if err := os.Rename(rf.path, archived); err != nil {
+		return err
+	}
+
+	if rf.opts.Compress {
+		if err := gzipFile(archived); err != nil {
+			return err
+		}
+	}
+
+	if err := rf.openCurrent(); err != nil {
+		return err
+	}
+
+	return rf.pruneBackups()
+}
+
+// archivePath returns the path rotate should archive the active file to:
+// rf.path plus a timestamp suffix, with a ".N" counter appended if that
+// path is already taken, so two rotations landing in the same second
+// don't clobber each other.
+func (rf *rotatingFile) archivePath() (string, error) {
+	base := rf.path + "." + time.Now().Format("2006-01-02T15-04-05")
+
+	path := base
+	for i := 1; ; i++ {
+		_, err := os.Stat(path)
+		if os.IsNotExist(err) && rf.opts.Compress {
+			_, err = os.Stat(path + ".gz")
+		}
+		if os.IsNotExist(err) {
+			return path, nil
+		}
+		if err != nil {
+			return "", err
+		}
+		path = fmt.Sprintf("%s.%d", base, i)
+	}
+}
+
+// pruneBackups removes archives of rf.path beyond the MaxBackups most
+// recent ones. It's a no-op when MaxBackups is zero.
+func (rf *rotatingFile) pruneBackups() error {
+	if rf.opts.MaxBackups <= 0 {
+		return nil
+	}
+
+	matches, err := filepath.Glob(rf.path + ".*")
+	if err != nil {
+		return err
+	}
+	if len(matches) <= rf.opts.MaxBackups {
+		return nil
+	}
+
+	sort.Strings(matches)
+	for _, old := range matches[:len(matches)-rf.opts.MaxBackups] {
+		if err := os.Remove(old); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// gzipFile compresses path in place, replacing it with path+".gz".
+func gzipFile(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	dst, err := os.Create(path + ".gz")
+	if err != nil {
+		return err
+	}
+
+	gw := gzip.NewWriter(dst)
+	if _, err := io.Copy(gw, src); err != nil {
+		gw.Close()
+		dst.Close()
+		return err
+	}
+	if err := gw.Close(); err != nil {
+		dst.Close()
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+
+	return os.Remove(path)
+}
@@ -0,0 +1,210 @@
+package logger
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestRotateDisambiguatesSameSecondArchives guards against two rotations
+// within the same wall-clock second resolving to the same archive path:
+// the second os.Rename would silently clobber the first archive's
+// content.
+func TestRotateDisambiguatesSameSecondArchives(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "std.log")
+
+	rf, err := newRotatingFile(path, Options{MaxSize: 1})
+	if err != nil {
+		t.Fatalf("newRotatingFile: %v", err)
+	}
+	defer rf.Close()
+
+	if _, err := rf.Write([]byte("first\n")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if _, err := rf.Write([]byte("second\n")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	matches, err := filepath.Glob(path + ".*")
+	if err != nil {
+		t.Fatalf("Glob: %v", err)
+	}
+	if len(matches) != 2 {
+		t.Fatalf("got %d archives %v, want 2", len(matches), matches)
+	}
+
+	var contents []string
+	for _, m := range matches {
+		data, err := os.ReadFile(m)
+		if err != nil {
+			t.Fatalf("ReadFile(%s): %v", m, err)
+		}
+		contents = append(contents, string(data))
+	}
+	if contents[0] == contents[1] {
+		t.Fatalf("both archives have identical content %q; one rotation clobbered the other", contents[0])
+	}
+}
+
+// TestRotateMaxSize guards against the size-based rotation trigger.
+func TestRotateMaxSize(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "std.log")
+
+	rf, err := newRotatingFile(path, Options{MaxSize: 5})
+	if err != nil {
+		t.Fatalf("newRotatingFile: %v", err)
+	}
+	defer rf.Close()
+
+	if _, err := rf.Write([]byte("12345")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if _, err := rf.Write([]byte("rotated")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	matches, err := filepath.Glob(path + ".*")
+	if err != nil {
+		t.Fatalf("Glob: %v", err)
+	}
+	if len(matches) != 1 {
+		t.Fatalf("got %d archives, want 1 after exceeding MaxSize", len(matches))
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile(active): %v", err)
+	}
+	if string(data) != "rotated" {
+		t.Errorf("active file = %q, want %q", data, "rotated")
+	}
+}
+
+// TestRotateMaxAge guards against the age-based rotation trigger.
+func TestRotateMaxAge(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "std.log")
+
+	rf, err := newRotatingFile(path, Options{MaxAge: time.Millisecond})
+	if err != nil {
+		t.Fatalf("newRotatingFile: %v", err)
+	}
+	defer rf.Close()
+
+	time.Sleep(5 * time.Millisecond)
+
+	if _, err := rf.Write([]byte("after max age")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	matches, err := filepath.Glob(path + ".*")
+	if err != nil {
+		t.Fatalf("Glob: %v", err)
+	}
+	if len(matches) != 1 {
+		t.Fatalf("got %d archives, want 1 after exceeding MaxAge", len(matches))
+	}
+}
+
+// TestRotateDaily guards against the daily rotation trigger firing once
+// rf's recorded day no longer matches the current one.
+func TestRotateDaily(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "std.log")
+
+	rf, err := newRotatingFile(path, Options{Daily: true})
+	if err != nil {
+		t.Fatalf("newRotatingFile: %v", err)
+	}
+	defer rf.Close()
+
+	rf.day = rf.openedAt.AddDate(0, 0, -1).YearDay()
+
+	if _, err := rf.Write([]byte("new day")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	matches, err := filepath.Glob(path + ".*")
+	if err != nil {
+		t.Fatalf("Glob: %v", err)
+	}
+	if len(matches) != 1 {
+		t.Fatalf("got %d archives, want 1 after crossing the daily boundary", len(matches))
+	}
+}
+
+// TestRotateMaxBackups guards against pruneBackups keeping the wrong
+// number of archives.
+func TestRotateMaxBackups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "std.log")
+
+	rf, err := newRotatingFile(path, Options{MaxSize: 1, MaxBackups: 2})
+	if err != nil {
+		t.Fatalf("newRotatingFile: %v", err)
+	}
+	defer rf.Close()
+
+	for i := 0; i < 4; i++ {
+		if _, err := rf.Write([]byte("xx")); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+		time.Sleep(time.Millisecond) // same-second archives get a .N suffix
+	}
+
+	matches, err := filepath.Glob(path + ".*")
+	if err != nil {
+		t.Fatalf("Glob: %v", err)
+	}
+	if len(matches) != 2 {
+		t.Fatalf("got %d archives %v, want 2 (MaxBackups)", len(matches), matches)
+	}
+}
+
+// TestRotateCompress guards against Compress failing to leave a valid,
+// readable gzip archive behind.
+func TestRotateCompress(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "std.log")
+
+	rf, err := newRotatingFile(path, Options{MaxSize: 5, Compress: true})
+	if err != nil {
+		t.Fatalf("newRotatingFile: %v", err)
+	}
+	defer rf.Close()
+
+	if _, err := rf.Write([]byte("12345")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if _, err := rf.Write([]byte("more")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+
+	matches, err := filepath.Glob(path + ".*.gz")
+	if err != nil {
+		t.Fatalf("Glob: %v", err)
+	}
+	if len(matches) != 1 {
+		t.Fatalf("got %d gzip archives %v, want 1", len(matches), matches)
+	}
+
+	f, err := os.Open(matches[0])
+	if err != nil {
+		t.Fatalf("Open(%s): %v", matches[0], err)
+	}
+	defer f.Close()
+
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatalf("gzip.NewReader: %v", err)
+	}
+	defer gr.Close()
+
+	data, err := io.ReadAll(gr)
+	if err != nil {
+		t.Fatalf("reading gzip archive: %v", err)
+	}
+	if string(data) != "12345" {
+		t.Errorf("decompressed archive = %q, want %q", data, "12345")
+	}
+}
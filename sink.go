@@ -0,0 +1,148 @@
+package logger
+
+import (
+	"io"
+	"os"
+	"sync"
+)
+
+// Sink receives every Entry a Logger logs, and is responsible for
+// filtering it by severity, rendering it, and delivering it somewhere: a
+// file, the terminal, syslog, or a remote collector.
+type Sink interface {
+	Write(Entry) error
+	Close() error
+}
+
+// FileSink writes entries at or above MinLevel to a single underlying
+// file, rendered with a Formatter that can be swapped at any time via
+// SetFormatter. It's the Sink New and NewWithOptions use to reproduce
+// logger's one-file-per-severity behaviour.
+type FileSink struct {
+	MinLevel Level
+
+	mu        sync.Mutex
+	formatter Formatter
+
+	file io.WriteCloser
+}
+
+// NewFileSink returns a FileSink writing entries at or above minLevel to
+// file, rendered with formatter. A nil formatter falls back to the
+// current default; see SetDefaultFormatter.
+func NewFileSink(file io.WriteCloser, minLevel Level, formatter Formatter) *FileSink {
+	if formatter == nil {
+		formatter = defaultFormatter
+	}
+	return &FileSink{MinLevel: minLevel, formatter: formatter, file: file}
+}
+
+// Write implements Sink.
+func (s *FileSink) Write(e Entry) error {
+	if e.Level < s.MinLevel {
+		return nil
+	}
+
+	s.mu.Lock()
+	formatter := s.formatter
+	s.mu.Unlock()
+
+	_, err := s.file.Write(formatter.Format(e))
+	return err
+}
+
+// Close implements Sink.
+func (s *FileSink) Close() error {
+	return s.file.Close()
+}
+
+// SetFormatter changes the Formatter s renders entries with.
+func (s *FileSink) SetFormatter(f Formatter) {
+	s.mu.Lock()
+	s.formatter = f
+	s.mu.Unlock()
+}
+
+// ANSI color codes used by StderrSink to tag WARNING, ERROR and FATAL
+// entries when Color is enabled.
+const (
+	ansiReset   = "\033[0m"
+	ansiYellow  = "\033[33m"
+	ansiBoldRed = "\033[1;31m"
+)
+
+// StderrSink mirrors entries at or above MinLevel to stderr, optionally
+// coloring them by severity: WARNING yellow, ERROR and FATAL bold red. Its
+// Formatter can be swapped at any time via SetFormatter.
+type StderrSink struct {
+	MinLevel Level
+	Color    bool
+
+	mu        sync.Mutex
+	formatter Formatter
+
+	out io.Writer
+}
+
+// NewStderrSink returns a StderrSink writing entries at or above minLevel
+// to os.Stderr using TextFormatter, optionally colored by severity.
+func NewStderrSink(minLevel Level, color bool) *StderrSink {
+	return &StderrSink{MinLevel: minLevel, formatter: TextFormatter{}, Color: color, out: os.Stderr}
+}
+
+// Write implements Sink.
+func (s *StderrSink) Write(e Entry) error {
+	if e.Level < s.MinLevel {
+		return nil
+	}
+
+	s.mu.Lock()
+	formatter := s.formatter
+	s.mu.Unlock()
+
+	data := formatter.Format(e)
+
+	code := s.colorFor(e.Level)
+	if code == "" {
+		_, err := s.out.Write(data)
+		return err
+	}
+
+	if _, err := io.WriteString(s.out, code); err != nil {
+		return err
+	}
+	if _, err := s.out.Write(data); err != nil {
+		return err
+	}
+	_, err := io.WriteString(s.out, ansiReset)
+	return err
+}
+
+// colorFor returns the ANSI color code for level, or "" when Color is
+// disabled or the severity isn't colored.
+func (s *StderrSink) colorFor(level Level) string {
+	if !s.Color {
+		return ""
+	}
+
+	switch level {
+	case WarningLevel:
+		return ansiYellow
+	case ErrorLevel, FatalLevel:
+		return ansiBoldRed
+	default:
+		return ""
+	}
+}
+
+// Close implements Sink. Stderr is never actually closed.
+func (s *StderrSink) Close() error {
+	return nil
+}
+
+// SetFormatter changes the Formatter s renders entries with.
+func (s *StderrSink) SetFormatter(f Formatter) {
+	s.mu.Lock()
+	s.formatter = f
+	s.mu.Unlock()
+}
@@ -0,0 +1,42 @@
+package logger
+
+import (
+	"io"
+	"sync"
+	"testing"
+)
+
+// discardWriteCloser implements io.WriteCloser by discarding everything
+// written to it, for use as a FileSink's backing file in tests.
+type discardWriteCloser struct{}
+
+func (discardWriteCloser) Write(p []byte) (int, error) { return len(p), nil }
+func (discardWriteCloser) Close() error                { return nil }
+
+var _ io.WriteCloser = discardWriteCloser{}
+
+// TestFileSinkConcurrentSetFormatter guards against FileSink's Formatter
+// field being read by Write and written by SetFormatter without
+// synchronization. Run with -race to catch a regression.
+func TestFileSinkConcurrentSetFormatter(t *testing.T) {
+	s := NewFileSink(discardWriteCloser{}, InfoLevel, TextFormatter{})
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			s.Write(Entry{Level: InfoLevel, Message: "hello"})
+		}
+	}()
+
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 100; i++ {
+			s.SetFormatter(JSONFormatter{})
+		}
+	}()
+
+	wg.Wait()
+}
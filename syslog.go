@@ -0,0 +1,49 @@
+//go:build !windows
+
+package logger
+
+import (
+	"log/syslog"
+)
+
+// SyslogSink mirrors entries at or above MinLevel to the local syslog
+// daemon, mapping logger's severities onto syslog's.
+type SyslogSink struct {
+	MinLevel Level
+
+	writer *syslog.Writer
+}
+
+// NewSyslogSink dials the local syslog daemon, tagging messages with tag,
+// and returns a SyslogSink delivering entries at or above minLevel to it.
+func NewSyslogSink(tag string, minLevel Level) (*SyslogSink, error) {
+	w, err := syslog.New(syslog.LOG_INFO, tag)
+	if err != nil {
+		return nil, err
+	}
+	return &SyslogSink{MinLevel: minLevel, writer: w}, nil
+}
+
+// Write implements Sink.
+func (s *SyslogSink) Write(e Entry) error {
+	if e.Level < s.MinLevel {
+		return nil
+	}
+
+	msg := e.Message
+	switch e.Level {
+	case WarningLevel:
+		return s.writer.Warning(msg)
+	case ErrorLevel:
+		return s.writer.Err(msg)
+	case FatalLevel:
+		return s.writer.Crit(msg)
+	default:
+		return s.writer.Info(msg)
+	}
+}
+
+// Close implements Sink.
+func (s *SyslogSink) Close() error {
+	return s.writer.Close()
+}
@@ -0,0 +1,23 @@
+//go:build !windows
+
+package logger
+
+import "testing"
+
+// TestSyslogSinkMinLevel guards against Write delivering entries below
+// MinLevel to the syslog daemon. Skipped when no local syslog daemon is
+// reachable, since NewSyslogSink dials one.
+func TestSyslogSinkMinLevel(t *testing.T) {
+	s, err := NewSyslogSink("logger-test", WarningLevel)
+	if err != nil {
+		t.Skipf("no local syslog daemon reachable: %v", err)
+	}
+	defer s.Close()
+
+	if err := s.Write(Entry{Level: InfoLevel, Message: "should be dropped"}); err != nil {
+		t.Errorf("Write(below MinLevel) = %v, want nil", err)
+	}
+	if err := s.Write(Entry{Level: ErrorLevel, Message: "should be delivered"}); err != nil {
+		t.Errorf("Write(above MinLevel) = %v, want nil", err)
+	}
+}